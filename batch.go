@@ -0,0 +1,40 @@
+package unique
+
+import (
+	"bytes"
+	"sort"
+)
+
+// NewIDs fills dst with freshly created IDs, in sorted order, using the
+// default Source. If the default Source supports batch generation (as the
+// monotonic Source NewID uses by default does), the batch takes the
+// Source's lock once and draws from the same entropy stream as NewID, so a
+// NewIDs batch and a NewID call in the same millisecond still sort
+// consistently with one another. This avoids the per-ID lock overhead that
+// calling NewID in a loop would incur; entropy is still drawn once per ID,
+// exactly as NewID draws it. For any other Source, NewID is called once per
+// element and the results are then sorted.
+func NewIDs(dst []ID) {
+	if len(dst) == 0 {
+		return
+	}
+
+	if b, ok := defaultSource.(batchSource); ok {
+		b.NewIDs(dst)
+		return
+	}
+	for i := range dst {
+		dst[i] = defaultSource.NewID()
+	}
+	sort.Slice(dst, func(i, j int) bool {
+		return bytes.Compare(dst[i][:], dst[j][:]) < 0
+	})
+}
+
+// NewIDsN is a convenience function equivalent to NewIDs that allocates and
+// returns a slice of n freshly created, sorted IDs.
+func NewIDsN(n int) []ID {
+	dst := make([]ID, n)
+	NewIDs(dst)
+	return dst
+}
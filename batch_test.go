@@ -0,0 +1,53 @@
+package unique
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestNewIDs(t *testing.T) {
+	t.Parallel()
+
+	ids := NewIDsN(1000)
+	if len(ids) != 1000 {
+		t.Fatalf("expected 1000 IDs, got %d", len(ids))
+	}
+
+	seen := map[ID]bool{}
+	for i, id := range ids {
+		if i > 0 && bytes.Compare(ids[i-1][:], id[:]) >= 0 {
+			t.Fatalf("ID %s did not sort strictly after previous ID %s", id, ids[i-1])
+		}
+		if seen[id] {
+			t.Errorf("ID %s collided within batch", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestNewIDsSharesDefaultSource(t *testing.T) {
+	t.Parallel()
+
+	// NewIDs and NewID should draw from the same Source, so IDs they create
+	// in the same millisecond still sort consistently with one another.
+	before := NewID()
+	batch := NewIDsN(10)
+	after := NewID()
+
+	if bytes.Compare(before[:], batch[0][:]) >= 0 {
+		t.Fatalf("NewID result %s did not sort before the following NewIDs batch %s", before, batch[0])
+	}
+	if bytes.Compare(batch[len(batch)-1][:], after[:]) >= 0 {
+		t.Fatalf("NewIDs batch did not sort before the following NewID result %s", after)
+	}
+}
+
+func TestNewIDsEmpty(t *testing.T) {
+	t.Parallel()
+
+	// Should not panic or allocate entropy for a zero-length or nil slice.
+	NewIDs(nil)
+	NewIDs([]ID{})
+
+	assertEqual(t, []ID{}, NewIDsN(0), "NewIDsN(0) should return an empty slice")
+}
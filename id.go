@@ -3,21 +3,103 @@ package unique
 import (
 	"crypto/rand"
 	"errors"
+	"io"
+	"sync"
 	"time"
 
 	"github.com/oklog/ulid"
 )
 
-var entropyReader = rand.Reader
-
 // ID is 128-bit sortable unique ID.
 //
 // See specification at https://github.com/ulid/spec
 type ID [16]byte
 
-// NewID creates a new unique ID.
+// Source generates new IDs. The zero value of a Source is not valid; use
+// NewMonotonicSource to create one.
+type Source interface {
+	NewID() ID
+}
+
+// batchSource is implemented by Sources that can fill a whole slice of IDs
+// more efficiently than calling NewID in a loop, such as by amortizing lock
+// acquisition and entropy reads across the batch. NewIDs uses it when the
+// default Source supports it.
+type batchSource interface {
+	NewIDs(dst []ID)
+}
+
+// entropyReader is the source of randomness backing defaultSource.
+var entropyReader io.Reader = rand.Reader
+
+// defaultSource is used by NewID, NewIDs, and NewIDsN. It generates
+// monotonically increasing IDs so that IDs created within the same
+// millisecond still sort strictly after one another.
+var defaultSource = NewMonotonicSource(entropyReader)
+
+// SetDefaultSource replaces the Source used by NewID. It is not safe to call
+// concurrently with NewID.
+func SetDefaultSource(s Source) {
+	defaultSource = s
+}
+
+// NewID creates a new unique ID using the default Source.
 func NewID() ID {
-	return ID(ulid.MustNew(ulid.Timestamp(time.Now()), entropyReader))
+	return defaultSource.NewID()
+}
+
+// monotonicSource is a Source that reuses the previous call's entropy and
+// increments it by a random delta whenever two IDs are created in the same
+// millisecond, guaranteeing the resulting IDs sort strictly after one
+// another. This is the pattern ulid.Monotonic implements at the entropy-
+// reader level; monotonicSource adapts it to the Source interface.
+type monotonicSource struct {
+	mu      sync.Mutex
+	entropy io.Reader
+}
+
+// NewMonotonicSource creates a Source that draws entropy from entropyReader
+// and increments it monotonically for IDs created within the same
+// millisecond. If incrementing overflows (the entropy is already all ones),
+// the timestamp is advanced by one millisecond instead, so an overflow never
+// makes NewID fail. NewID panics if entropyReader returns an error.
+func NewMonotonicSource(entropyReader io.Reader) Source {
+	return &monotonicSource{entropy: ulid.Monotonic(entropyReader, 0)}
+}
+
+// NewID creates a new unique ID, reusing and incrementing the previous
+// entropy if called again within the same millisecond.
+func (s *monotonicSource) NewID() ID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.newIDLocked()
+}
+
+// NewIDs fills dst with freshly created IDs, in sorted order, acquiring the
+// Source's lock once for the whole batch rather than once per ID.
+func (s *monotonicSource) NewIDs(dst []ID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range dst {
+		dst[i] = s.newIDLocked()
+	}
+}
+
+// newIDLocked creates a new unique ID. Callers must hold s.mu.
+func (s *monotonicSource) newIDLocked() ID {
+	ms := ulid.Timestamp(time.Now())
+	id, err := ulid.New(ms, s.entropy)
+	for err == ulid.ErrMonotonicOverflow {
+		ms++
+		id, err = ulid.New(ms, s.entropy)
+	}
+	if err != nil {
+		// entropy is an ulid.Monotonic reader wrapping the caller's reader;
+		// the only other failure mode is the reader itself erroring out.
+		panic(err)
+	}
+	return ID(id)
 }
 
 // Bytes returns a byte slice representation of an ID.
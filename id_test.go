@@ -1,6 +1,7 @@
 package unique
 
 import (
+	"bytes"
 	"fmt"
 	"reflect"
 	"testing"
@@ -34,6 +35,19 @@ func TestNewID(t *testing.T) {
 	}
 }
 
+func TestNewIDMonotonic(t *testing.T) {
+	t.Parallel()
+
+	var prev ID
+	for i := 0; i < 100000; i++ {
+		id := NewID()
+		if i > 0 && bytes.Compare(prev[:], id[:]) >= 0 {
+			t.Fatalf("ID %s did not sort strictly after previous ID %s", id, prev)
+		}
+		prev = id
+	}
+}
+
 func TestSetTime(t *testing.T) {
 	t.Parallel()
 
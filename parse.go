@@ -0,0 +1,33 @@
+package unique
+
+// ParseLenient parses s as an ID, tolerating common transcription mistakes:
+// ASCII dashes and whitespace are stripped, letters are uppercased, and the
+// Crockford-ambiguous substitutions (O -> 0, I/L -> 1) are applied before
+// decoding. Unlike UnmarshalText, which requires the exact canonical form,
+// ParseLenient is meant for user-entered input.
+func ParseLenient(s string) (ID, error) {
+	buf := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '-', ' ', '\t', '\n', '\r':
+			continue
+		}
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		switch c {
+		case 'O':
+			c = '0'
+		case 'I', 'L':
+			c = '1'
+		}
+		buf = append(buf, c)
+	}
+
+	var id ID
+	if err := id.UnmarshalText(buf); err != nil {
+		return ID{}, err
+	}
+	return id, nil
+}
@@ -0,0 +1,40 @@
+package unique
+
+import "testing"
+
+func TestParseLenient(t *testing.T) {
+	t.Parallel()
+
+	var wantID ID
+	assertNoErr(t, wantID.UnmarshalText([]byte("01DXF6DT0004HMASW9NF6YZW0D")), "canonical text should parse")
+
+	tests := []string{
+		"01DXF6DT0004HMASW9NF6YZW0D",
+		"01dxf6dt0004hmasw9nf6yzw0d",
+		"01DX-F6DT-0004-HMAS-W9NF-6YZW-0D",
+		"  01DXF6DT0004HMASW9NF6YZW0D  ",
+	}
+
+	for _, s := range tests {
+		id, err := ParseLenient(s)
+		if assertNoErr(t, err, "ParseLenient(%q) should succeed", s) {
+			assertEqual(t, wantID, id, "ParseLenient(%q)", s)
+		}
+	}
+
+	if _, err := ParseLenient("not-a-valid-id-at-all-nope!!"); err == nil {
+		t.Error("ParseLenient should reject garbage input")
+	}
+}
+
+func TestParseLenientAmbiguousChars(t *testing.T) {
+	t.Parallel()
+
+	// O, I, and L are Crockford-ambiguous with 0 and 1 respectively.
+	id, err := ParseLenient("OIL00000000000000000000000")
+	if assertNoErr(t, err, "ParseLenient should substitute ambiguous characters") {
+		var want ID
+		assertNoErr(t, want.UnmarshalText([]byte("01100000000000000000000000")), "canonical text should parse")
+		assertEqual(t, want, id, "ParseLenient ambiguous substitution")
+	}
+}
@@ -0,0 +1,28 @@
+package unique
+
+import "time"
+
+// maxEntropy is entropy with every bit set, the largest value ID's entropy
+// component can hold.
+var maxEntropy = []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+// MinIDForTime returns the smallest possible ID with timestamp t (entropy
+// all-zero). It is useful as the inclusive lower bound of a time-ranged
+// query, since the timestamp occupies the leading 48 bits of an ID.
+func MinIDForTime(t time.Time) ID {
+	return ID{}.WithTime(t)
+}
+
+// MaxIDForTime returns the largest possible ID with timestamp t (entropy
+// all-ones). It is useful as the inclusive upper bound of a time-ranged
+// query.
+func MaxIDForTime(t time.Time) ID {
+	return ID{}.WithTime(t).WithEntropy(maxEntropy)
+}
+
+// RangeForInterval returns the inclusive [min, max] ID bounds covering the
+// half-open time interval [start, end), suitable for a range scan in a KV
+// store or a `WHERE id BETWEEN ? AND ?` query in SQL.
+func RangeForInterval(start, end time.Time) (min, max ID) {
+	return MinIDForTime(start), MaxIDForTime(end.Add(-time.Millisecond))
+}
@@ -0,0 +1,60 @@
+package unique
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMinMaxIDForTime(t *testing.T) {
+	t.Parallel()
+
+	min := MinIDForTime(midTime)
+	max := MaxIDForTime(midTime)
+
+	assertEqual(t, midTime, min.Time(), "MinIDForTime should preserve the timestamp")
+	assertEqual(t, midTime, max.Time(), "MaxIDForTime should preserve the timestamp")
+	assertEqual(t, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0}, min.Entropy(), "MinIDForTime should zero the entropy")
+	assertEqual(t, maxEntropy, max.Entropy(), "MaxIDForTime should max out the entropy")
+
+	if !lessID(min, max) {
+		t.Error("MinIDForTime should sort before MaxIDForTime for the same timestamp")
+	}
+
+	// Any ID stamped with midTime, regardless of entropy, should fall within
+	// [min, max].
+	mid := NewID().WithTime(midTime)
+	if lessID(mid, min) || lessID(max, mid) {
+		t.Error("an ID at midTime should fall within [MinIDForTime(midTime), MaxIDForTime(midTime)]")
+	}
+}
+
+func TestRangeForInterval(t *testing.T) {
+	t.Parallel()
+
+	start := midTime
+	end := midTime.Add(2 * time.Millisecond)
+
+	min, max := RangeForInterval(start, end)
+	assertEqual(t, MinIDForTime(start), min, "RangeForInterval min bound")
+	assertEqual(t, MaxIDForTime(start.Add(time.Millisecond)), max, "RangeForInterval max bound")
+
+	// An ID at the start of the interval is in range; one at (or after) the
+	// exclusive end is not.
+	inRange := NewID().WithTime(start)
+	atEnd := NewID().WithTime(end)
+	if lessID(inRange, min) || lessID(max, inRange) {
+		t.Error("an ID at the interval start should fall within the returned range")
+	}
+	if !lessID(max, atEnd) {
+		t.Error("an ID at the exclusive end should fall outside the returned range")
+	}
+}
+
+func lessID(a, b ID) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
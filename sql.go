@@ -0,0 +1,41 @@
+package unique
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// SQLBinaryMode controls how Value encodes an ID for database/sql. When
+// false (the default), Value emits the canonical 26-character text form,
+// suitable for CHAR/VARCHAR columns. When true, Value emits the raw 16
+// bytes, suitable for BYTEA/UUID columns.
+var SQLBinaryMode = false
+
+// Value implements the database/sql/driver.Valuer interface.
+func (id ID) Value() (driver.Value, error) {
+	if SQLBinaryMode {
+		return id.Bytes(), nil
+	}
+	return id.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface. It accepts a 16-byte
+// []byte, a 26-character Crockford-encoded string (or the []byte
+// equivalent), or nil (which yields a zero ID).
+func (id *ID) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*id = ID{}
+		return nil
+	case []byte:
+		if len(v) == 16 {
+			copy(id[:], v)
+			return nil
+		}
+		return id.UnmarshalText(v)
+	case string:
+		return id.UnmarshalText([]byte(v))
+	default:
+		return fmt.Errorf("id cannot scan type %T", src)
+	}
+}
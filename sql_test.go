@@ -0,0 +1,59 @@
+package unique
+
+import (
+	"testing"
+)
+
+func TestSQLValueText(t *testing.T) {
+	old := SQLBinaryMode
+	defer func() { SQLBinaryMode = old }()
+	SQLBinaryMode = false
+
+	id := NewID()
+	v, err := id.Value()
+	if assertNoErr(t, err, "Value should succeed") {
+		assertEqual(t, id.String(), v, "Value should emit canonical text form")
+	}
+}
+
+func TestSQLValueBinary(t *testing.T) {
+	old := SQLBinaryMode
+	defer func() { SQLBinaryMode = old }()
+	SQLBinaryMode = true
+
+	id := NewID()
+	v, err := id.Value()
+	if assertNoErr(t, err, "Value should succeed") {
+		assertEqual(t, id.Bytes(), v, "Value should emit raw bytes")
+	}
+}
+
+func TestSQLScan(t *testing.T) {
+	t.Parallel()
+
+	want := NewID()
+
+	var fromBinary ID
+	assertNoErr(t, fromBinary.Scan(want.Bytes()), "Scan from []byte should succeed")
+	assertEqual(t, want, fromBinary, "Scan from []byte")
+
+	var fromString ID
+	assertNoErr(t, fromString.Scan(want.String()), "Scan from string should succeed")
+	assertEqual(t, want, fromString, "Scan from string")
+
+	var fromTextBytes ID
+	assertNoErr(t, fromTextBytes.Scan([]byte(want.String())), "Scan from text []byte should succeed")
+	assertEqual(t, want, fromTextBytes, "Scan from text []byte")
+
+	var fromNil ID
+	fromNil.MustSetTime(midTime)
+	assertNoErr(t, fromNil.Scan(nil), "Scan from nil should succeed")
+	if !fromNil.IsZero() {
+		t.Error("Scan from nil should yield a zero ID")
+	}
+
+	var bad ID
+	if err := bad.Scan(42); err == nil {
+		t.Error("Scan from unsupported type should fail")
+	}
+}
@@ -0,0 +1,108 @@
+package unique
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+)
+
+// FromUUID converts a raw UUID (or any other 16-byte value) to an ID. No
+// bits are rearranged: UUIDs and IDs are both opaque 128-bit values, so this
+// is a zero-copy reinterpretation, not a format conversion.
+func FromUUID(u [16]byte) ID {
+	return ID(u)
+}
+
+// ToUUID converts an ID to a raw UUID, such as github.com/google/uuid.UUID.
+// No bits are rearranged; see FromUUID.
+func (id ID) ToUUID() [16]byte {
+	return [16]byte(id)
+}
+
+// UUIDString returns the ID's bytes formatted as a hyphenated UUID
+// (8-4-4-4-12 hex digits), for interop with UUID-native systems.
+func (id ID) UUIDString() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], id[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], id[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], id[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], id[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:36], id[10:16])
+	return string(buf[:])
+}
+
+// ParseUUID parses s as a UUID, accepting both the hyphenated 8-4-4-4-12
+// form and the 32-character unhyphenated hex form.
+func ParseUUID(s string) (ID, error) {
+	switch len(s) {
+	case 36:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return ID{}, errors.New("id uses invalid UUID encoding")
+		}
+		s = s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:36]
+	case 32:
+		// Already bare hex.
+	default:
+		return ID{}, errors.New("id uses invalid UUID encoding")
+	}
+
+	var id ID
+	if _, err := hex.Decode(id[:], []byte(s)); err != nil {
+		return ID{}, errors.New("id uses invalid UUID encoding")
+	}
+	return id, nil
+}
+
+// JSONEncoding selects the text form ID uses for MarshalJSON and
+// UnmarshalJSON.
+type JSONEncoding int
+
+const (
+	// JSONULID encodes IDs as canonical Crockford base32 ULID text. This is
+	// the default.
+	JSONULID JSONEncoding = iota
+	// JSONUUID encodes IDs as hyphenated UUID text, for services bridging a
+	// ULID-native API with a UUID-native one.
+	JSONUUID
+)
+
+// JSONMode controls the text form used by MarshalJSON and UnmarshalJSON.
+var JSONMode = JSONULID
+
+// MarshalJSON implements the encoding/json.Marshaler interface. The text
+// form is selected by JSONMode.
+func (id ID) MarshalJSON() ([]byte, error) {
+	if JSONMode == JSONUUID {
+		return json.Marshal(id.UUIDString())
+	}
+
+	text, err := id.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface. The text
+// form is selected by JSONMode.
+func (id *ID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if JSONMode == JSONUUID {
+		parsed, err := ParseUUID(s)
+		if err != nil {
+			return err
+		}
+		*id = parsed
+		return nil
+	}
+
+	return id.UnmarshalText([]byte(s))
+}
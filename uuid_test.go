@@ -0,0 +1,61 @@
+package unique
+
+import "testing"
+
+func TestUUIDRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	id := NewID()
+	raw := id.ToUUID()
+	assertEqual(t, id, FromUUID(raw), "FromUUID(id.ToUUID()) should round-trip")
+}
+
+func TestUUIDString(t *testing.T) {
+	t.Parallel()
+
+	var id ID
+	id.MustSetTime(midTime)
+	id.SetEntropy([]byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0xf0, 0x0d})
+
+	want := "016f5e66-e800-0123-4567-89abcdeff00d"
+	assertEqual(t, want, id.UUIDString(), "UUIDString")
+
+	parsed, err := ParseUUID(want)
+	if assertNoErr(t, err, "ParseUUID should accept hyphenated form") {
+		assertEqual(t, id, parsed, "ParseUUID hyphenated")
+	}
+
+	parsed, err = ParseUUID("016f5e66e80001234567" + "89abcdeff00d")
+	if assertNoErr(t, err, "ParseUUID should accept bare hex form") {
+		assertEqual(t, id, parsed, "ParseUUID bare hex")
+	}
+
+	if _, err := ParseUUID("not-a-uuid"); err == nil {
+		t.Error("ParseUUID should reject malformed input")
+	}
+}
+
+func TestJSONMode(t *testing.T) {
+	old := JSONMode
+	defer func() { JSONMode = old }()
+
+	id := NewID()
+
+	JSONMode = JSONULID
+	data, err := id.MarshalJSON()
+	if assertNoErr(t, err, "MarshalJSON (ULID mode) should succeed") {
+		var decoded ID
+		if assertNoErr(t, decoded.UnmarshalJSON(data), "UnmarshalJSON (ULID mode) should succeed") {
+			assertEqual(t, id, decoded, "JSON round-trip (ULID mode)")
+		}
+	}
+
+	JSONMode = JSONUUID
+	data, err = id.MarshalJSON()
+	if assertNoErr(t, err, "MarshalJSON (UUID mode) should succeed") {
+		var decoded ID
+		if assertNoErr(t, decoded.UnmarshalJSON(data), "UnmarshalJSON (UUID mode) should succeed") {
+			assertEqual(t, id, decoded, "JSON round-trip (UUID mode)")
+		}
+	}
+}